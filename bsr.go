@@ -0,0 +1,183 @@
+package sparse
+
+import (
+	"github.com/gonum/matrix"
+	"github.com/gonum/matrix/mat64"
+)
+
+// BSR is a Block Sparse Row format sparse matrix implementation and implements the Matrix interface
+// from gonum/matrix.  BSR is well suited to matrices whose non-zeros cluster into small dense blocks
+// (common in FEM, graph embeddings and multi-feature ML) since it amortises the indptr/ind bookkeeping
+// over a whole block rather than a single element and lets the inner per-block loops vectorise, unlike
+// CSR/CSC which address one non-zero at a time.
+type BSR struct {
+	i, j   int // matrix dimensions in elements
+	br, bc int // block dimensions in elements
+	indptr []int
+	ind    []int
+	data   []float64
+}
+
+// NewBSR creates a new Block Sparse Row format sparse matrix.  The matrix is initialised to the size
+// of the specified r * c dimensions (rows * columns, in elements) with blocks of size br * bc.  indptr
+// and ind describe the sparsity pattern in block units exactly as CSR's indptr/ind do in element units
+// i.e. ind[indptr[bi]:indptr[bi+1]] holds the block-column indices of the non-zero blocks in block-row
+// bi.  data holds the nnz_blocks * br * bc values in row-major order within each block, concatenated in
+// the same order as ind.  The supplied slices will be used as the backing storage to the matrix so
+// changes to values of the slices will be reflected in the created matrix and vice versa.
+func NewBSR(r, c, br, bc int, indptr []int, ind []int, data []float64) *BSR {
+	if br <= 0 || r%br != 0 {
+		panic(matrix.ErrRowAccess)
+	}
+	if bc <= 0 || c%bc != 0 {
+		panic(matrix.ErrColAccess)
+	}
+
+	return &BSR{
+		i: r, j: c,
+		br: br, bc: bc,
+		indptr: indptr,
+		ind:    ind,
+		data:   data,
+	}
+}
+
+// Dims returns the size of the matrix as the number of rows and columns
+func (b *BSR) Dims() (int, int) {
+	return b.i, b.j
+}
+
+// At returns the element of the matrix located at row i and column j.  At will panic if specified values
+// for i or j fall outside the dimensions of the matrix.
+func (b *BSR) At(i, j int) float64 {
+	if uint(i) < 0 || uint(i) >= uint(b.i) {
+		panic(matrix.ErrRowAccess)
+	}
+	if uint(j) < 0 || uint(j) >= uint(b.j) {
+		panic(matrix.ErrColAccess)
+	}
+
+	bi, bj := i/b.br, j/b.bc
+	oi, oj := i%b.br, j%b.bc
+
+	for k := b.indptr[bi]; k < b.indptr[bi+1]; k++ {
+		if b.ind[k] == bj {
+			return b.data[k*b.br*b.bc+oi*b.bc+oj]
+		}
+	}
+
+	return 0
+}
+
+// T transposes the matrix, returning it as a CSC (the transpose of ToCSR) that shares no backing
+// storage with the receiver.
+func (b *BSR) T() mat64.Matrix {
+	return b.ToCSR().T()
+}
+
+// NNZ returns the Number of Non Zero elements in the sparse matrix i.e. the number of non-zero blocks
+// multiplied by the number of elements per block - it makes no attempt to detect zero values within a
+// stored block.
+func (b *BSR) NNZ() int {
+	return len(b.ind) * b.br * b.bc
+}
+
+// ToDense returns a mat64.Dense dense format version of the matrix.  The returned mat64.Dense
+// matrix will not share underlying storage with the receiver.
+func (b *BSR) ToDense() *mat64.Dense {
+	mat := mat64.NewDense(b.i, b.j, nil)
+
+	for bi := 0; bi < len(b.indptr)-1; bi++ {
+		for k := b.indptr[bi]; k < b.indptr[bi+1]; k++ {
+			bj := b.ind[k]
+			block := b.data[k*b.br*b.bc : (k+1)*b.br*b.bc]
+			for oi := 0; oi < b.br; oi++ {
+				for oj := 0; oj < b.bc; oj++ {
+					mat.Set(bi*b.br+oi, bj*b.bc+oj, block[oi*b.bc+oj])
+				}
+			}
+		}
+	}
+
+	return mat
+}
+
+// ToCSR returns a Compressed Sparse Row sparse format version of the matrix.  The returned CSR matrix
+// will not share underlying storage with the receiver.
+func (b *BSR) ToCSR() *CSR {
+	indptr := make([]int, b.i+1)
+	var ind []int
+	var data []float64
+
+	t := 0
+	for bi := 0; bi < len(b.indptr)-1; bi++ {
+		for oi := 0; oi < b.br; oi++ {
+			row := bi*b.br + oi
+			indptr[row] = t
+			for k := b.indptr[bi]; k < b.indptr[bi+1]; k++ {
+				bj := b.ind[k]
+				block := b.data[k*b.br*b.bc : (k+1)*b.br*b.bc]
+				for oj := 0; oj < b.bc; oj++ {
+					if v := block[oi*b.bc+oj]; v != 0 {
+						ind = append(ind, bj*b.bc+oj)
+						data = append(data, v)
+						t++
+					}
+				}
+			}
+		}
+	}
+	indptr[b.i] = t
+
+	return NewCSR(b.i, b.j, indptr, ind, data)
+}
+
+// ToCSC returns a Compressed Sparse Column sparse format version of the matrix.  The returned CSC
+// matrix will not share underlying storage with the receiver.
+func (b *BSR) ToCSC() *CSC {
+	return b.ToCSR().ToCSC()
+}
+
+// ToType returns an alternative format version of the matrix in the format specified, dispatching
+// through MatrixType.Convert exactly as CSR.ToType and CSC.ToType do.  MatrixType.Convert has no BSR
+// target format, so no conversion from another format produces a BSR; BSR matrices are built with
+// NewBSR.
+func (b *BSR) ToType(matType MatrixType) mat64.Matrix {
+	return matType.Convert(b)
+}
+
+// MulVec computes the matrix vector product of the receiver and x, storing the result in dst i.e.
+// dst = A * x.  This is where BSR wins over CSR/CSC: each non-zero block is applied as a small dense
+// br x bc gemv, so the inner loops vectorise instead of addressing one non-zero at a time.  MulVec will
+// panic if the number of columns in the receiver does not match the length of x, or if dst is not the
+// same length as the number of rows in the receiver.
+func (b *BSR) MulVec(dst *mat64.Vector, x *mat64.Vector) {
+	if x.Len() != b.j {
+		panic(matrix.ErrShape)
+	}
+	if dst.Len() != b.i {
+		panic(matrix.ErrShape)
+	}
+
+	for i := 0; i < b.i; i++ {
+		dst.SetVec(i, 0)
+	}
+
+	for bi := 0; bi < len(b.indptr)-1; bi++ {
+		acc := make([]float64, b.br)
+		for k := b.indptr[bi]; k < b.indptr[bi+1]; k++ {
+			bj := b.ind[k]
+			block := b.data[k*b.br*b.bc : (k+1)*b.br*b.bc]
+			for oi := 0; oi < b.br; oi++ {
+				var v float64
+				for oj := 0; oj < b.bc; oj++ {
+					v += block[oi*b.bc+oj] * x.At(bj*b.bc+oj, 0)
+				}
+				acc[oi] += v
+			}
+		}
+		for oi := 0; oi < b.br; oi++ {
+			dst.SetVec(bi*b.br+oi, acc[oi])
+		}
+	}
+}
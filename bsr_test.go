@@ -0,0 +1,109 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/gonum/matrix"
+	"github.com/gonum/matrix/mat64"
+)
+
+// newTestBSR builds a 4x4 matrix with 2x2 blocks, only the diagonal blocks populated:
+//
+//	[1 2 0 0]
+//	[3 4 0 0]
+//	[0 0 5 6]
+//	[0 0 7 8]
+func newTestBSR() *BSR {
+	indptr := []int{0, 1, 2}
+	ind := []int{0, 1}
+	data := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+	return NewBSR(4, 4, 2, 2, indptr, ind, data)
+}
+
+func wantBSRDense() [][]float64 {
+	return [][]float64{
+		{1, 2, 0, 0},
+		{3, 4, 0, 0},
+		{0, 0, 5, 6},
+		{0, 0, 7, 8},
+	}
+}
+
+func TestBSRAt(t *testing.T) {
+	b := newTestBSR()
+	want := wantBSRDense()
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if got := b.At(i, j); got != want[i][j] {
+				t.Errorf("At(%d,%d) = %v, want %v", i, j, got, want[i][j])
+			}
+		}
+	}
+}
+
+func TestBSRToDense(t *testing.T) {
+	b := newTestBSR()
+	want := wantBSRDense()
+	dense := b.ToDense()
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if got := dense.At(i, j); got != want[i][j] {
+				t.Errorf("ToDense().At(%d,%d) = %v, want %v", i, j, got, want[i][j])
+			}
+		}
+	}
+}
+
+func TestBSRToCSR(t *testing.T) {
+	b := newTestBSR()
+	want := wantBSRDense()
+	csr := b.ToCSR()
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if got := csr.At(i, j); got != want[i][j] {
+				t.Errorf("ToCSR().At(%d,%d) = %v, want %v", i, j, got, want[i][j])
+			}
+		}
+	}
+}
+
+func TestBSRToCSC(t *testing.T) {
+	b := newTestBSR()
+	want := wantBSRDense()
+	csc := b.ToCSC()
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if got := csc.At(i, j); got != want[i][j] {
+				t.Errorf("ToCSC().At(%d,%d) = %v, want %v", i, j, got, want[i][j])
+			}
+		}
+	}
+}
+
+func TestBSRMulVec(t *testing.T) {
+	b := newTestBSR()
+	x := mat64.NewVector(4, []float64{1, 1, 1, 1})
+	dst := mat64.NewVector(4, nil)
+	b.MulVec(dst, x)
+
+	want := []float64{3, 7, 11, 15}
+	for i, w := range want {
+		if got := dst.At(i, 0); got != w {
+			t.Errorf("MulVec()[%d] = %v, want %v", i, got, w)
+		}
+	}
+}
+
+func TestNewBSRPanicsOnZeroBlockDims(t *testing.T) {
+	check := func(name string, br, bc int, want error) {
+		defer func() {
+			if r := recover(); r != want {
+				t.Errorf("%s: recover() = %v, want %v", name, r, want)
+			}
+		}()
+		NewBSR(2, 2, br, bc, []int{0, 0}, nil, nil)
+	}
+
+	check("br=0", 0, 1, matrix.ErrRowAccess)
+	check("bc=0", 1, 0, matrix.ErrColAccess)
+}
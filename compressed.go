@@ -1,17 +1,122 @@
 package sparse
 
 import (
+	"errors"
+	"sort"
+
 	"github.com/gonum/matrix"
 	"github.com/gonum/matrix/mat64"
 )
 
+// errSymmetricView is panicked by the raw row/column view and non-zero iterator APIs (RowView,
+// DoRowNonZero, ColNonZeroIterator and their CSC mirrors) when called on a symmetric (StorageLower/
+// StorageUpper) matrix.  Unlike At/ToDense/ToDOK/ToCOO/MulVec, these APIs are explicitly zero-copy (or
+// walk only the physically stored triangle) so they cannot synthesise the mirrored entries without
+// giving up that guarantee; callers that need the full, symmetry-aware view should go through
+// ToCSR/ToCSC/ToDense first.
+var errSymmetricView = errors.New("sparse: row/column views and non-zero iterators only support StorageFull matrices; convert via ToCSR/ToCSC/ToDense first")
+
+// Sparser is implemented by any sparse matrix type in this package that can produce a CSR view of
+// itself without loss of information.  It is used to detect when both operands of a Mul are sparse
+// so that specialised sparse x sparse kernels (e.g. Gustavson's algorithm) can be used in place of
+// the generic At-based reduction.
+type Sparser interface {
+	mat64.Matrix
+	ToCSR() *CSR
+}
+
+// Storage indicates which triangle of a square matrix is physically stored in a compressedSparse,
+// relative to the storage's own major axis (rows for CSR, columns for CSC).  StorageFull (the zero
+// value) stores every element as normal.  StorageLower/StorageUpper store only the entries whose minor
+// index is <= or >= their major index respectively and rely on At (and the conversion/SpMV methods) to
+// mirror the missing triangle, roughly halving the memory needed for symmetric matrices (Laplacians,
+// Gram matrices, graph adjacency) at the cost of read-only symmetry: writes are not mirrored automatically.
+type Storage int
+
+// Supported Storage values.
+const (
+	StorageFull Storage = iota
+	StorageLower
+	StorageUpper
+)
+
 // compressedSparse represents the common structure for representing compressed sparse
 // matrix formats e.g. CSR (Compressed Sparse Row) or CSC (Compressed Sparse Column)
 type compressedSparse struct {
-	i, j   int
-	indptr []int
-	ind    []int
-	data   []float64
+	i, j    int
+	indptr  []int
+	ind     []int
+	data    []float64
+	Storage Storage
+	sorted  bool
+}
+
+// HasSortedIndices returns whether, within each major (row for CSR, column for CSC) segment, the
+// minor indices in ind are strictly increasing with no duplicates.  Many algorithms (binary search in
+// At, merge-based row ops, symmetry checks, Matrix Market round-tripping) require or benefit from this
+// invariant; call SortIndices to establish it.
+func (c *compressedSparse) HasSortedIndices() bool {
+	return c.sorted
+}
+
+// checkSortedIndices scans the receiver's storage and sets sorted if every major segment already has
+// strictly increasing minor indices.  It never mutates ind/data - use SortIndices for that.
+func (c *compressedSparse) checkSortedIndices() {
+	for i := 0; i < len(c.indptr)-1; i++ {
+		start, end := c.indptr[i], c.indptr[i+1]
+		for k := start + 1; k < end; k++ {
+			if c.ind[k-1] >= c.ind[k] {
+				c.sorted = false
+				return
+			}
+		}
+	}
+	c.sorted = true
+}
+
+// sortIndices canonicalises the receiver's storage in place so that, within each major segment, minor
+// indices are strictly increasing and any duplicate entries have been summed together.  Afterwards,
+// HasSortedIndices reports true.
+func (c *compressedSparse) sortIndices() {
+	for i := 0; i < len(c.indptr)-1; i++ {
+		start, end := c.indptr[i], c.indptr[i+1]
+		// insertion sort: rows/columns are typically short so this stays allocation-free.
+		for p := start + 1; p < end; p++ {
+			j, v := c.ind[p], c.data[p]
+			q := p - 1
+			for q >= start && c.ind[q] > j {
+				c.ind[q+1] = c.ind[q]
+				c.data[q+1] = c.data[q]
+				q--
+			}
+			c.ind[q+1] = j
+			c.data[q+1] = v
+		}
+	}
+
+	// sum duplicate entries within each segment, compacting indptr/ind/data as we go.
+	newIndptr := make([]int, len(c.indptr))
+	w := 0
+	for i := 0; i < len(c.indptr)-1; i++ {
+		newIndptr[i] = w
+		start, end := c.indptr[i], c.indptr[i+1]
+		for k := start; k < end; {
+			j, v := c.ind[k], c.data[k]
+			k++
+			for k < end && c.ind[k] == j {
+				v += c.data[k]
+				k++
+			}
+			c.ind[w], c.data[w] = j, v
+			w++
+		}
+	}
+	newIndptr[len(c.indptr)-1] = w
+
+	c.indptr = newIndptr
+	c.ind = c.ind[:w]
+	c.data = c.data[:w]
+	c.sorted = true
 }
 
 // NNZ returns the Number of Non Zero elements in the sparse matrix.
@@ -19,6 +124,28 @@ func (c *compressedSparse) NNZ() int {
 	return len(c.data)
 }
 
+// EffNNZ returns the effective Number of Non Zero elements represented by the matrix, taking Storage
+// into account.  For StorageFull this is identical to NNZ(); for StorageLower/StorageUpper, stored
+// off-diagonal elements are counted twice since they represent values at both their stored coordinate
+// and its symmetric mirror.
+func (c *compressedSparse) EffNNZ() int {
+	if c.Storage == StorageFull {
+		return c.NNZ()
+	}
+
+	n := 0
+	for i := 0; i < len(c.indptr)-1; i++ {
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			if c.ind[k] == i {
+				n++
+			} else {
+				n += 2
+			}
+		}
+	}
+	return n
+}
+
 // at returns the element of the matrix located at coordinate i, j.  Depending upon the
 // context and the type of compressed sparse (CSR or CSC) i and j could represent rows
 // and columns or columns and rows respectively.
@@ -30,8 +157,29 @@ func (c *compressedSparse) at(i, j int) float64 {
 		panic(matrix.ErrColAccess)
 	}
 
-	// todo: consider a binary search if we can assume the data is ordered.
-	for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+	// a symmetric matrix only stores one triangle - mirror into it if (i, j) falls in the other.
+	switch c.Storage {
+	case StorageLower:
+		if j > i {
+			i, j = j, i
+		}
+	case StorageUpper:
+		if j < i {
+			i, j = j, i
+		}
+	}
+
+	start, end := c.indptr[i], c.indptr[i+1]
+	if c.sorted {
+		ind := c.ind[start:end]
+		pos := sort.SearchInts(ind, j)
+		if pos < len(ind) && ind[pos] == j {
+			return c.data[start+pos]
+		}
+		return 0
+	}
+
+	for k := start; k < end; k++ {
 		if c.ind[k] == j {
 			return c.data[k]
 		}
@@ -40,6 +188,86 @@ func (c *compressedSparse) at(i, j int) float64 {
 	return 0
 }
 
+// expand walks every stored element of the receiver and returns it as (major, minor, value) triples,
+// where major is the storage's own major axis index (row for CSR, column for CSC) and minor is the
+// other axis.  For StorageLower/StorageUpper, off-diagonal elements are additionally emitted with
+// major and minor swapped so that callers building a full representation (ToDense, ToDOK, ToCOO) pick
+// up the mirrored triangle.
+func (c *compressedSparse) expand() (major, minor []int, data []float64) {
+	major = make([]int, 0, c.NNZ())
+	minor = make([]int, 0, c.NNZ())
+	data = make([]float64, 0, c.NNZ())
+
+	for i := 0; i < len(c.indptr)-1; i++ {
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			j := c.ind[k]
+			major = append(major, i)
+			minor = append(minor, j)
+			data = append(data, c.data[k])
+
+			if c.Storage != StorageFull && j != i {
+				major = append(major, j)
+				minor = append(minor, i)
+				data = append(data, c.data[k])
+			}
+		}
+	}
+
+	return
+}
+
+// NonZeroIterator iterates over the non-zero elements along an axis that a compressed sparse matrix
+// cannot natively address without conversion - columns of a CSR, or rows of a CSC.  It is returned by
+// ColNonZeroIterator/RowNonZeroIterator and walks the backing indptr/ind/data lazily, one major
+// segment (row for CSR, column for CSC) at a time, without materialising a transpose.
+type NonZeroIterator struct {
+	c     *compressedSparse
+	major int
+	limit int
+	minor int
+	index int
+	value float64
+}
+
+// Next advances the iterator to the next non-zero element and reports whether one was found.  Index
+// and Value are only valid after a call to Next that returned true.
+func (it *NonZeroIterator) Next() bool {
+	for ; it.major < it.limit; it.major++ {
+		start, end := it.c.indptr[it.major], it.c.indptr[it.major+1]
+
+		if it.c.sorted {
+			ind := it.c.ind[start:end]
+			pos := sort.SearchInts(ind, it.minor)
+			if pos < len(ind) && ind[pos] == it.minor {
+				it.index, it.value = it.major, it.c.data[start+pos]
+				it.major++
+				return true
+			}
+			continue
+		}
+
+		for k := start; k < end; k++ {
+			if it.c.ind[k] == it.minor {
+				it.index, it.value = it.major, it.c.data[k]
+				it.major++
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Index returns the native-axis index (row, for ColNonZeroIterator; column, for RowNonZeroIterator) of
+// the element found by the most recent call to Next.
+func (it *NonZeroIterator) Index() int {
+	return it.index
+}
+
+// Value returns the value of the element found by the most recent call to Next.
+func (it *NonZeroIterator) Value() float64 {
+	return it.value
+}
+
 // CSR is a Compressed Sparse Row format sparse matrix implementation (sometimes called Compressed Row
 // Storage (CRS) format) and implements the Matrix interface from gonum/matrix.  This allows large sparse
 // (mostly zero values) matrices to be stored efficiently in memory (only storing non-zero values).
@@ -77,7 +305,7 @@ func NewCSR(r int, c int, ia []int, ja []int, data []float64) *CSR {
 		panic(matrix.ErrColAccess)
 	}
 
-	return &CSR{
+	csr := &CSR{
 		compressedSparse: compressedSparse{
 			i: r, j: c,
 			indptr: ia,
@@ -85,6 +313,28 @@ func NewCSR(r int, c int, ia []int, ja []int, data []float64) *CSR {
 			data:   data,
 		},
 	}
+	csr.checkSortedIndices()
+
+	return csr
+}
+
+// SortIndices canonicalises the receiver's storage in place so that, within each row, column indices
+// are strictly increasing and any duplicate entries (same row and column) have been summed together.
+// Most callers will not need to call this directly since NewCSR already detects and records whether
+// its input is sorted, but it is useful after building a CSR incrementally (e.g. via COO.ToCSR) from
+// unordered input.
+func (c *CSR) SortIndices() {
+	c.sortIndices()
+}
+
+// NewSymCSR creates a new symmetric n x n Compressed Sparse Row format sparse matrix storing only the
+// triangle indicated by storage (StorageLower or StorageUpper).  At, ToDense, ToDOK, ToCOO and MulVec
+// transparently mirror the non-stored triangle so the matrix behaves like a full n x n matrix.  As with
+// NewCSR, the supplied slices are used as the backing storage of the matrix.
+func NewSymCSR(n int, indptr []int, ind []int, data []float64, storage Storage) *CSR {
+	csr := NewCSR(n, n, indptr, ind, data)
+	csr.Storage = storage
+	return csr
 }
 
 // Dims returns the size of the matrix as the number of rows and columns
@@ -112,6 +362,9 @@ func (c *CSR) ToDense() *mat64.Dense {
 	for i := 0; i < len(c.indptr)-1; i++ {
 		for j := c.indptr[i]; j < c.indptr[i+1]; j++ {
 			mat.Set(i, c.ind[j], c.data[j])
+			if c.Storage != StorageFull && c.ind[j] != i {
+				mat.Set(c.ind[j], i, c.data[j])
+			}
 		}
 	}
 
@@ -125,6 +378,9 @@ func (c *CSR) ToDOK() *DOK {
 	for i := 0; i < len(c.indptr)-1; i++ {
 		for j := c.indptr[i]; j < c.indptr[i+1]; j++ {
 			dok.Set(i, c.ind[j], c.data[j])
+			if c.Storage != StorageFull && c.ind[j] != i {
+				dok.Set(c.ind[j], i, c.data[j])
+			}
 		}
 	}
 
@@ -134,8 +390,14 @@ func (c *CSR) ToDOK() *DOK {
 // ToCOO returns a COOrdinate sparse format version of the matrix.  The returned COO matrix will
 // share underlying storage with the receiver so any changes to either matrices will be reflected
 // in the other.  NB this includes sorting the ordering of the non zero elements in the COO matrix
-// e.g. for CSC conversion.
+// e.g. for CSC conversion.  For a symmetric (StorageLower/StorageUpper) receiver the non-stored
+// triangle is materialised so the returned COO cannot share storage with the receiver.
 func (c *CSR) ToCOO() *COO {
+	if c.Storage != StorageFull {
+		rows, cols, data := c.expand()
+		return NewCOO(c.i, c.j, rows, cols, data)
+	}
+
 	rows := make([]int, c.NNZ())
 
 	for i := 0; i < len(c.indptr)-1; i++ {
@@ -149,9 +411,37 @@ func (c *CSR) ToCOO() *COO {
 	return coo
 }
 
-// ToCSR returns the receiver
+// ToCSR returns the receiver if it uses full storage.  For a symmetric (StorageLower/StorageUpper)
+// receiver, returning the receiver as-is would silently drop the mirrored triangle for any caller that
+// (reasonably) expects ToCSR to hand back a full CSR view - e.g. mulSparse, which uses ToCSR to
+// normalise both Mul operands to CSR before applying Gustavson's algorithm - so in that case a new,
+// fully expanded, StorageFull CSR is returned instead and does not share storage with the receiver.
 func (c *CSR) ToCSR() *CSR {
-	return c
+	if c.Storage == StorageFull {
+		return c
+	}
+
+	major, minor, data := c.expand()
+
+	indptr := make([]int, c.i+1)
+	for _, m := range major {
+		indptr[m+1]++
+	}
+	for i := 0; i < c.i; i++ {
+		indptr[i+1] += indptr[i]
+	}
+
+	ind := make([]int, len(data))
+	vals := make([]float64, len(data))
+	next := append([]int(nil), indptr[:c.i]...)
+	for k, m := range major {
+		pos := next[m]
+		ind[pos] = minor[k]
+		vals[pos] = data[k]
+		next[m]++
+	}
+
+	return NewCSR(c.i, c.j, indptr, ind, vals)
 }
 
 // ToCSC returns a Compressed Sparse Column sparse format version of the matrix.  The returned CSC matrix will
@@ -187,6 +477,35 @@ func (c *CSR) Mul(a, b mat64.Matrix) {
 		return
 	}
 
+	if lhs, ok := a.(*CSR); ok && bc == 1 {
+		if x, ok := b.(*mat64.Vector); ok {
+			dst := mat64.NewVector(ar, nil)
+			lhs.MulVec(dst, x)
+			c.i, c.j = ar, 1
+			c.indptr = make([]int, ar+1)
+			c.ind = nil
+			c.data = nil
+			t := 0
+			for i := 0; i < ar; i++ {
+				c.indptr[i] = t
+				if v := dst.At(i, 0); v != 0 {
+					c.ind = append(c.ind, 0)
+					c.data = append(c.data, v)
+					t++
+				}
+			}
+			c.indptr[ar] = t
+			return
+		}
+	}
+
+	if lhs, ok := a.(Sparser); ok {
+		if rhs, ok := b.(Sparser); ok {
+			c.mulSparse(lhs, rhs)
+			return
+		}
+	}
+
 	c.indptr = make([]int, ar+1)
 
 	c.i, c.j = ar, bc
@@ -234,6 +553,181 @@ func (c *CSR) Mul(a, b mat64.Matrix) {
 	c.indptr[c.i] = t
 }
 
+// MulVec computes the matrix vector product of the receiver and x, storing the result in dst i.e.
+// dst = A * x.  This is the SpMV (Sparse matrix-Vector) kernel and is the single most heavily used
+// operation for sparse matrices in many ML and graph workloads so it streams directly over indptr,
+// ind and data rather than going through the generic Mul/At path.  MulVec will panic if the number of
+// columns in the receiver does not match the length of x, or if dst is not the same length as the
+// number of rows in the receiver.
+func (c *CSR) MulVec(dst *mat64.Vector, x *mat64.Vector) {
+	if x.Len() != c.j {
+		panic(matrix.ErrShape)
+	}
+	if dst.Len() != c.i {
+		panic(matrix.ErrShape)
+	}
+
+	if c.Storage != StorageFull {
+		// symmetry optimisation: each stored entry (i, j) contributes to both dst[i] and dst[j] in a
+		// single traversal, so only half the entries of the full matrix are stored and walked (the
+		// number of multiply-adds is the same as for the full matrix).
+		for i := 0; i < c.i; i++ {
+			dst.SetVec(i, 0)
+		}
+		for i := 0; i < c.i; i++ {
+			for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+				j := c.ind[k]
+				v := c.data[k]
+				dst.SetVec(i, dst.At(i, 0)+v*x.At(j, 0))
+				if j != i {
+					dst.SetVec(j, dst.At(j, 0)+v*x.At(i, 0))
+				}
+			}
+		}
+		return
+	}
+
+	xraw := x.RawVector()
+	if xraw.Inc == 1 {
+		for i := 0; i < c.i; i++ {
+			var v float64
+			for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+				v += c.data[k] * xraw.Data[c.ind[k]]
+			}
+			dst.SetVec(i, v)
+		}
+		return
+	}
+
+	for i := 0; i < c.i; i++ {
+		var v float64
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			v += c.data[k] * x.At(c.ind[k], 0)
+		}
+		dst.SetVec(i, v)
+	}
+}
+
+// MulVecTrans computes the matrix vector product of the transpose of the receiver and x, storing the
+// result in dst i.e. dst = A^T * x, without materialising the transpose.  MulVecTrans will panic if the
+// number of rows in the receiver does not match the length of x, or if dst is not the same length as
+// the number of columns in the receiver.
+func (c *CSR) MulVecTrans(dst *mat64.Vector, x *mat64.Vector) {
+	if x.Len() != c.i {
+		panic(matrix.ErrShape)
+	}
+	if dst.Len() != c.j {
+		panic(matrix.ErrShape)
+	}
+
+	if c.Storage != StorageFull {
+		// a symmetric matrix is its own transpose.
+		c.MulVec(dst, x)
+		return
+	}
+
+	for j := 0; j < c.j; j++ {
+		dst.SetVec(j, 0)
+	}
+
+	xraw := x.RawVector()
+	if xraw.Inc == 1 {
+		for i := 0; i < c.i; i++ {
+			xi := xraw.Data[i]
+			if xi == 0 {
+				continue
+			}
+			for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+				dst.SetVec(c.ind[k], dst.At(c.ind[k], 0)+c.data[k]*xi)
+			}
+		}
+		return
+	}
+
+	for i := 0; i < c.i; i++ {
+		xi := x.At(i, 0)
+		if xi == 0 {
+			continue
+		}
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			dst.SetVec(c.ind[k], dst.At(c.ind[k], 0)+c.data[k]*xi)
+		}
+	}
+}
+
+// mulSparse takes the matrix product of two sparse operands, a and b, and stores the result in the
+// receiver using Gustavson's algorithm.  Both operands are coerced to CSR (this is a cheap no-op for
+// a StorageFull CSR operand, an expansion into a new full CSR for a symmetric-storage CSR operand and
+// a ToCOO/ToCSR round trip for a CSC operand) so the kernel only has to deal with a single layout.  Gustavson's algorithm runs in O(flops) rather than the O(ar*bc*nnz-per-row) of the
+// generic At-based reduction: for each row of a, a dense workspace xw accumulates the row of the
+// product while nextNonZero marks which columns have already been touched (-1 meaning untouched) so
+// that each column is only added to the row's index list once.
+func (c *CSR) mulSparse(a, b Sparser) {
+	lhs := a.ToCSR()
+	rhs := b.ToCSR()
+
+	ar, _ := lhs.Dims()
+	_, bc := rhs.Dims()
+
+	indptr := make([]int, ar+1)
+	// lhs/rhs may alias the receiver (e.g. c.Mul(c, b), since ToCSR on a StorageFull CSR returns the
+	// receiver itself) so the result is built up in fresh slices rather than reusing/truncating c.ind
+	// and c.data, which would let later appends clobber the very backing array lhs/rhs are still
+	// reading from.
+	var ind []int
+	var data []float64
+
+	xw := make([]float64, bc)
+	nextNonZero := make([]int, bc)
+	for j := range nextNonZero {
+		nextNonZero[j] = -1
+	}
+
+	var rowind []int
+	t := 0
+	for i := 0; i < ar; i++ {
+		indptr[i] = t
+		rowind = rowind[:0]
+
+		for k := lhs.indptr[i]; k < lhs.indptr[i+1]; k++ {
+			av := lhs.data[k]
+			brow := lhs.ind[k]
+
+			for kk := rhs.indptr[brow]; kk < rhs.indptr[brow+1]; kk++ {
+				j := rhs.ind[kk]
+				v := av * rhs.data[kk]
+
+				if nextNonZero[j] == -1 {
+					nextNonZero[j] = 1
+					rowind = append(rowind, j)
+					xw[j] = v
+				} else {
+					xw[j] += v
+				}
+			}
+		}
+
+		sort.Ints(rowind)
+		for _, j := range rowind {
+			if xw[j] != 0 {
+				ind = append(ind, j)
+				data = append(data, xw[j])
+				t++
+			}
+			xw[j] = 0
+			nextNonZero[j] = -1
+		}
+	}
+	indptr[ar] = t
+
+	c.i, c.j = ar, bc
+	c.indptr = indptr
+	c.ind = ind
+	c.data = data
+	c.Storage = StorageFull
+	c.sorted = true
+}
+
 // mulDIA takes the matrix product of the diagonal matrix dia and an other matrix, other and stores the result
 // in the receiver.  This method caters for the specialised case of multiplying by a diagonal matrix where significant
 // optimisation is possible due to the sparsity pattern of the matrix.  If trans is true, the method will assume that
@@ -305,6 +799,54 @@ func (c *CSR) RowNNZ(i int) int {
 	return c.indptr[i+1] - c.indptr[i]
 }
 
+// RowView returns the column indices and values of the non-zero elements of row i as slices aliasing
+// the receiver's backing storage - no copy is made, so mutating the returned slices mutates the
+// matrix and the slices are only valid until the next structural change to the receiver (e.g.
+// SortIndices).  Because it is zero-copy, RowView only ever sees the physically stored triangle of a
+// symmetric (StorageLower/StorageUpper) matrix, not the mirrored entries At/ToDense/MulVec account
+// for, so it panics on anything but StorageFull - call ToCSR/ToDense first to get a full view.  RowView
+// will also panic if i is out of range.
+func (c *CSR) RowView(i int) (ind []int, data []float64) {
+	if c.Storage != StorageFull {
+		panic(errSymmetricView)
+	}
+	if uint(i) < 0 || uint(i) >= uint(c.i) {
+		panic(matrix.ErrRowAccess)
+	}
+	return c.ind[c.indptr[i]:c.indptr[i+1]], c.data[c.indptr[i]:c.indptr[i+1]]
+}
+
+// DoRowNonZero calls fn for each non-zero element of row i, passing its column index and value.  Like
+// RowView, it only walks the physically stored triangle, so it panics on anything but a StorageFull
+// matrix - call ToCSR/ToDense first to get a full view.  DoRowNonZero will also panic if i is out of
+// range.
+func (c *CSR) DoRowNonZero(i int, fn func(j int, v float64)) {
+	if c.Storage != StorageFull {
+		panic(errSymmetricView)
+	}
+	if uint(i) < 0 || uint(i) >= uint(c.i) {
+		panic(matrix.ErrRowAccess)
+	}
+	for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+		fn(c.ind[k], c.data[k])
+	}
+}
+
+// ColNonZeroIterator returns an iterator over the non-zero elements of column j.  Unlike RowView/
+// DoRowNonZero, a column is not natively addressable in CSR without conversion, so this walks indptr
+// lazily, row by row, rather than materialising a transpose via ToCSC.  It only searches the physically
+// stored triangle, so it panics on anything but a StorageFull matrix - call ToCSR/ToDense first to get
+// a full view.  ColNonZeroIterator will also panic if j is out of range.
+func (c *CSR) ColNonZeroIterator(j int) *NonZeroIterator {
+	if c.Storage != StorageFull {
+		panic(errSymmetricView)
+	}
+	if uint(j) < 0 || uint(j) >= uint(c.j) {
+		panic(matrix.ErrColAccess)
+	}
+	return &NonZeroIterator{c: &c.compressedSparse, limit: c.i, minor: j}
+}
+
 // CSC is a Compressed Sparse Column format sparse matrix implementation (sometimes called Compressed Column
 // Storage (CCS) format) and implements the Matrix interface from gonum/matrix.  This allows large sparse
 // (mostly zero values) matrices to be stored efficiently in memory (only storing non-zero values).
@@ -342,7 +884,7 @@ func NewCSC(r int, c int, indptr []int, ind []int, data []float64) *CSC {
 		panic(matrix.ErrColAccess)
 	}
 
-	return &CSC{
+	csc := &CSC{
 		compressedSparse: compressedSparse{
 			i: c, j: r,
 			indptr: indptr,
@@ -350,6 +892,28 @@ func NewCSC(r int, c int, indptr []int, ind []int, data []float64) *CSC {
 			data:   data,
 		},
 	}
+	csc.checkSortedIndices()
+
+	return csc
+}
+
+// SortIndices canonicalises the receiver's storage in place so that, within each column, row indices
+// are strictly increasing and any duplicate entries (same row and column) have been summed together.
+// Most callers will not need to call this directly since NewCSC already detects and records whether
+// its input is sorted, but it is useful after building a CSC incrementally (e.g. via COO.ToCSC) from
+// unordered input.
+func (c *CSC) SortIndices() {
+	c.sortIndices()
+}
+
+// NewSymCSC creates a new symmetric n x n Compressed Sparse Column format sparse matrix storing only
+// the triangle indicated by storage (StorageLower or StorageUpper).  At, ToDense, ToDOK, ToCOO and
+// MulVec transparently mirror the non-stored triangle so the matrix behaves like a full n x n matrix.
+// As with NewCSC, the supplied slices are used as the backing storage of the matrix.
+func NewSymCSC(n int, indptr []int, ind []int, data []float64, storage Storage) *CSC {
+	csc := NewCSC(n, n, indptr, ind, data)
+	csc.Storage = storage
+	return csc
 }
 
 // Dims returns the size of the matrix as the number of rows and columns
@@ -377,6 +941,9 @@ func (c *CSC) ToDense() *mat64.Dense {
 	for i := 0; i < len(c.indptr)-1; i++ {
 		for j := c.indptr[i]; j < c.indptr[i+1]; j++ {
 			mat.Set(c.ind[j], i, c.data[j])
+			if c.Storage != StorageFull && c.ind[j] != i {
+				mat.Set(i, c.ind[j], c.data[j])
+			}
 		}
 	}
 
@@ -390,6 +957,9 @@ func (c *CSC) ToDOK() *DOK {
 	for i := 0; i < len(c.indptr)-1; i++ {
 		for j := c.indptr[i]; j < c.indptr[i+1]; j++ {
 			dok.Set(c.ind[j], i, c.data[j])
+			if c.Storage != StorageFull && c.ind[j] != i {
+				dok.Set(i, c.ind[j], c.data[j])
+			}
 		}
 	}
 
@@ -399,8 +969,14 @@ func (c *CSC) ToDOK() *DOK {
 // ToCOO returns a COOrdinate sparse format version of the matrix.  The returned COO matrix will
 // share underlying storage with the receiver so any changes to either matrices will be reflected
 // in the other.  NB this includes sorting the ordering of the non zero elements in the COO matrix
-// e.g. for CSR conversion.
+// e.g. for CSR conversion.  For a symmetric (StorageLower/StorageUpper) receiver the non-stored
+// triangle is materialised so the returned COO cannot share storage with the receiver.
 func (c *CSC) ToCOO() *COO {
+	if c.Storage != StorageFull {
+		major, minor, data := c.expand()
+		return NewCOO(c.j, c.i, minor, major, data)
+	}
+
 	cols := make([]int, c.NNZ())
 
 	for i := 0; i < len(c.indptr)-1; i++ {
@@ -431,4 +1007,161 @@ func (c *CSC) ToCSC() *CSC {
 // ToType returns an alternative format version fo the matrix in the format specified.
 func (c *CSC) ToType(matType MatrixType) mat64.Matrix {
 	return matType.Convert(c)
+}
+
+// MulVec computes the matrix vector product of the receiver and x, storing the result in dst i.e.
+// dst = A * x.  This is the SpMV (Sparse matrix-Vector) kernel and streams directly over indptr, ind
+// and data rather than going through the generic Mul/At path.  Because CSC stores data column major,
+// the natural accumulation pattern is column-by-column into dst rather than the row-major reduction
+// used by CSR.MulVec.  MulVec will panic if the number of columns in the receiver does not match the
+// length of x, or if dst is not the same length as the number of rows in the receiver.
+func (c *CSC) MulVec(dst *mat64.Vector, x *mat64.Vector) {
+	if x.Len() != c.i {
+		panic(matrix.ErrShape)
+	}
+	if dst.Len() != c.j {
+		panic(matrix.ErrShape)
+	}
+
+	for i := 0; i < c.j; i++ {
+		dst.SetVec(i, 0)
+	}
+
+	if c.Storage != StorageFull {
+		// symmetry optimisation: each stored entry (row, col) contributes to both dst[row] and
+		// dst[col] in a single traversal, so only half the entries of the full matrix are walked (the
+		// number of multiply-adds is unchanged).
+		for col := 0; col < c.i; col++ {
+			for k := c.indptr[col]; k < c.indptr[col+1]; k++ {
+				row := c.ind[k]
+				v := c.data[k]
+				dst.SetVec(row, dst.At(row, 0)+v*x.At(col, 0))
+				if row != col {
+					dst.SetVec(col, dst.At(col, 0)+v*x.At(row, 0))
+				}
+			}
+		}
+		return
+	}
+
+	xraw := x.RawVector()
+	if xraw.Inc == 1 {
+		for col := 0; col < c.i; col++ {
+			xc := xraw.Data[col]
+			if xc == 0 {
+				continue
+			}
+			for k := c.indptr[col]; k < c.indptr[col+1]; k++ {
+				dst.SetVec(c.ind[k], dst.At(c.ind[k], 0)+c.data[k]*xc)
+			}
+		}
+		return
+	}
+
+	for col := 0; col < c.i; col++ {
+		xc := x.At(col, 0)
+		if xc == 0 {
+			continue
+		}
+		for k := c.indptr[col]; k < c.indptr[col+1]; k++ {
+			dst.SetVec(c.ind[k], dst.At(c.ind[k], 0)+c.data[k]*xc)
+		}
+	}
+}
+
+// MulVecTrans computes the matrix vector product of the transpose of the receiver and x, storing the
+// result in dst i.e. dst = A^T * x, without materialising the transpose.  Since each column of the CSC
+// corresponds to a row of A^T, this reduces to the same row-major accumulation used by CSR.MulVec.
+// MulVecTrans will panic if the number of rows in the receiver does not match the length of x, or if
+// dst is not the same length as the number of columns in the receiver.
+func (c *CSC) MulVecTrans(dst *mat64.Vector, x *mat64.Vector) {
+	if x.Len() != c.j {
+		panic(matrix.ErrShape)
+	}
+	if dst.Len() != c.i {
+		panic(matrix.ErrShape)
+	}
+
+	if c.Storage != StorageFull {
+		// a symmetric matrix is its own transpose.
+		c.MulVec(dst, x)
+		return
+	}
+
+	xraw := x.RawVector()
+	if xraw.Inc == 1 {
+		for col := 0; col < c.i; col++ {
+			var v float64
+			for k := c.indptr[col]; k < c.indptr[col+1]; k++ {
+				v += c.data[k] * xraw.Data[c.ind[k]]
+			}
+			dst.SetVec(col, v)
+		}
+		return
+	}
+
+	for col := 0; col < c.i; col++ {
+		var v float64
+		for k := c.indptr[col]; k < c.indptr[col+1]; k++ {
+			v += c.data[k] * x.At(c.ind[k], 0)
+		}
+		dst.SetVec(col, v)
+	}
+}
+
+// ColNNZ returns the Number of Non Zero values in the specified column j.  ColNNZ will panic if j is
+// out of range.
+func (c *CSC) ColNNZ(j int) int {
+	if uint(j) < 0 || uint(j) >= uint(c.i) {
+		panic(matrix.ErrColAccess)
+	}
+	return c.indptr[j+1] - c.indptr[j]
+}
+
+// ColView returns the row indices and values of the non-zero elements of column j as slices aliasing
+// the receiver's backing storage - no copy is made, so mutating the returned slices mutates the
+// matrix and the slices are only valid until the next structural change to the receiver (e.g.
+// SortIndices).  Because it is zero-copy, ColView only ever sees the physically stored triangle of a
+// symmetric (StorageLower/StorageUpper) matrix, not the mirrored entries At/ToDense/MulVec account
+// for, so it panics on anything but StorageFull - call ToCSC/ToDense first to get a full view.  ColView
+// will also panic if j is out of range.
+func (c *CSC) ColView(j int) (ind []int, data []float64) {
+	if c.Storage != StorageFull {
+		panic(errSymmetricView)
+	}
+	if uint(j) < 0 || uint(j) >= uint(c.i) {
+		panic(matrix.ErrColAccess)
+	}
+	return c.ind[c.indptr[j]:c.indptr[j+1]], c.data[c.indptr[j]:c.indptr[j+1]]
+}
+
+// DoColNonZero calls fn for each non-zero element of column j, passing its row index and value.  Like
+// ColView, it only walks the physically stored triangle, so it panics on anything but a StorageFull
+// matrix - call ToCSC/ToDense first to get a full view.  DoColNonZero will also panic if j is out of
+// range.
+func (c *CSC) DoColNonZero(j int, fn func(i int, v float64)) {
+	if c.Storage != StorageFull {
+		panic(errSymmetricView)
+	}
+	if uint(j) < 0 || uint(j) >= uint(c.i) {
+		panic(matrix.ErrColAccess)
+	}
+	for k := c.indptr[j]; k < c.indptr[j+1]; k++ {
+		fn(c.ind[k], c.data[k])
+	}
+}
+
+// RowNonZeroIterator returns an iterator over the non-zero elements of row i.  Unlike ColView/
+// DoColNonZero, a row is not natively addressable in CSC without conversion, so this walks indptr
+// lazily, column by column, rather than materialising a transpose via ToCSR.  It only searches the
+// physically stored triangle, so it panics on anything but a StorageFull matrix - call ToCSC/ToDense
+// first to get a full view.  RowNonZeroIterator will also panic if i is out of range.
+func (c *CSC) RowNonZeroIterator(i int) *NonZeroIterator {
+	if c.Storage != StorageFull {
+		panic(errSymmetricView)
+	}
+	if uint(i) < 0 || uint(i) >= uint(c.j) {
+		panic(matrix.ErrRowAccess)
+	}
+	return &NonZeroIterator{c: &c.compressedSparse, limit: c.i, minor: i}
 }
\ No newline at end of file
@@ -0,0 +1,116 @@
+package sparse
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestMatrixMarketRoundTrip(t *testing.T) {
+	src := "%%MatrixMarket matrix coordinate real general\n3 3 3\n1 1 2\n2 2 3\n3 3 4\n"
+
+	coo, err := ReadMatrixMarket(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr := coo.ToCSR()
+
+	var buf bytes.Buffer
+	if err := csr.MarshalMatrixMarket(&buf, false); err != nil {
+		t.Fatal(err)
+	}
+
+	coo2, err := ReadMatrixMarket(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr2 := coo2.ToCSR()
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if got, want := csr2.At(i, j), csr.At(i, j); got != want {
+				t.Errorf("round trip At(%d,%d) = %v, want %v", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestReadMatrixMarketSymmetricExpandsTriangle(t *testing.T) {
+	// only the lower triangle (row >= col) is present in the file.
+	src := "%%MatrixMarket matrix coordinate real symmetric\n3 3 4\n1 1 2\n2 1 1\n2 2 2\n3 3 4\n"
+
+	coo, err := ReadMatrixMarket(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr := coo.ToCSR()
+
+	want := [][]float64{
+		{2, 1, 0},
+		{1, 2, 0},
+		{0, 0, 4},
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if got := csr.At(i, j); got != want[i][j] {
+				t.Errorf("At(%d,%d) = %v, want %v", i, j, got, want[i][j])
+			}
+		}
+	}
+}
+
+func TestReadHarwellBoeing(t *testing.T) {
+	// minimal assembled real HB file for:
+	//   [1 0 0]
+	//   [2 3 0]
+	//   [0 0 4]
+	src := strings.Join([]string{
+		"Test matrix                                                            key1",
+		"4             1             1             1             0",
+		"RUA                      3             3             4             0",
+		"(4I3)           (4I3)           (4F4.1)",
+		"1  3  4  5",
+		"1  2  2  3",
+		"1.0 2.0 3.0 4.0",
+		"",
+	}, "\n")
+
+	csc, err := ReadHarwellBoeing(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]float64{
+		{1, 0, 0},
+		{2, 3, 0},
+		{0, 0, 4},
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if got := csc.At(i, j); got != want[i][j] {
+				t.Errorf("At(%d,%d) = %v, want %v", i, j, got, want[i][j])
+			}
+		}
+	}
+}
+
+func TestReadMatrixMarketMalformedRealEntryReturnsError(t *testing.T) {
+	// the entry line is missing its value field - this must be reported as an error, not panic with an
+	// index-out-of-range reading fields[2].
+	src := "%%MatrixMarket matrix coordinate real general\n1 1 1\n1 1\n"
+
+	_, err := ReadMatrixMarket(strings.NewReader(src))
+	if err == nil {
+		t.Fatal("ReadMatrixMarket(malformed real entry) = nil error, want error")
+	}
+}
+
+func TestReadMatrixMarketMalformedPatternEntryReturnsError(t *testing.T) {
+	// pattern entries only need row/col, but still need at least those two fields.
+	src := "%%MatrixMarket matrix coordinate pattern general\n1 1 1\n1\n"
+
+	_, err := ReadMatrixMarket(strings.NewReader(src))
+	if err == nil {
+		t.Fatal("ReadMatrixMarket(malformed pattern entry) = nil error, want error")
+	}
+}
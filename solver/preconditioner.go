@@ -0,0 +1,157 @@
+// Package solver provides iterative methods for solving sparse linear systems A x = b, built on top
+// of the MulVec SpMV kernels exposed by the sparse package.
+package solver
+
+import (
+	"github.com/gonum/matrix/mat64"
+	"github.com/klokare/sparse"
+)
+
+// Preconditioner applies an approximate inverse of the coefficient matrix A to r, storing the result
+// in z, in order to accelerate convergence of the iterative solvers in this package.  Implementations
+// must tolerate z and r aliasing the same backing storage.
+type Preconditioner interface {
+	Apply(z, r *mat64.Vector)
+}
+
+// IdentityPreconditioner is a no-op Preconditioner (M = I), used when no preconditioning is requested.
+type IdentityPreconditioner struct{}
+
+// Apply copies r into z unchanged.
+func (IdentityPreconditioner) Apply(z, r *mat64.Vector) {
+	z.CopyVec(r)
+}
+
+// JacobiPreconditioner preconditions using the inverse of the diagonal of A i.e. z = D^-1 r.  It is
+// cheap to build and apply and works well when A is diagonally dominant.
+type JacobiPreconditioner struct {
+	invDiag []float64
+}
+
+// NewJacobiPreconditioner builds a JacobiPreconditioner from the diagonal of a.  A zero diagonal entry
+// is treated as 1 to avoid dividing by zero.
+func NewJacobiPreconditioner(a mat64.Matrix) *JacobiPreconditioner {
+	n, _ := a.Dims()
+	invDiag := make([]float64, n)
+	for i := 0; i < n; i++ {
+		d := a.At(i, i)
+		if d == 0 {
+			d = 1
+		}
+		invDiag[i] = 1 / d
+	}
+	return &JacobiPreconditioner{invDiag: invDiag}
+}
+
+// Apply sets z[i] = invDiag[i] * r[i].
+func (p *JacobiPreconditioner) Apply(z, r *mat64.Vector) {
+	for i, d := range p.invDiag {
+		z.SetVec(i, d*r.At(i, 0))
+	}
+}
+
+// ILU0Preconditioner is an incomplete LU factorisation with no fill-in (ILU(0)): it reuses exactly the
+// sparsity pattern of the CSR matrix it is built from, trading some accuracy of the factorisation for
+// the same memory footprint as A.  It typically converges in far fewer iterations than Jacobi for
+// matrices arising from PDE discretisations, at the cost of a more expensive Apply (two triangular
+// solves instead of a single scale).
+type ILU0Preconditioner struct {
+	n      int
+	indptr []int
+	ind    []int
+	lu     []float64
+}
+
+// NewILU0Preconditioner computes the ILU(0) factorisation of a, following the algorithm in Saad's
+// "Iterative Methods for Sparse Linear Systems" (Algorithm 10.4).  The factorisation loop requires
+// each row's stored entries to be in increasing column order - if a does not already report
+// HasSortedIndices, it is sorted in place first (a.SortIndices shares backing storage with a, so this
+// is visible to the caller too) before the pattern is copied out; the copy itself (indptr/ind/lu) is
+// left untouched by SortIndices afterwards.
+func NewILU0Preconditioner(a *sparse.CSR) *ILU0Preconditioner {
+	if !a.HasSortedIndices() {
+		a.SortIndices()
+	}
+
+	n, _ := a.Dims()
+
+	indptr := make([]int, n+1)
+	var ind []int
+	var lu []float64
+	for i := 0; i < n; i++ {
+		rind, rdata := a.RowView(i)
+		indptr[i+1] = indptr[i] + len(rind)
+		ind = append(ind, rind...)
+		lu = append(lu, rdata...)
+	}
+
+	find := func(row, col int) (int, bool) {
+		for k := indptr[row]; k < indptr[row+1]; k++ {
+			if ind[k] == col {
+				return k, true
+			}
+		}
+		return 0, false
+	}
+
+	for i := 1; i < n; i++ {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			col := ind[k]
+			if col >= i {
+				continue
+			}
+
+			diagPos, ok := find(col, col)
+			if !ok || lu[diagPos] == 0 {
+				continue
+			}
+
+			lu[k] /= lu[diagPos]
+			factor := lu[k]
+
+			for j := k + 1; j < indptr[i+1]; j++ {
+				jcol := ind[j]
+				if p, ok := find(col, jcol); ok {
+					lu[j] -= factor * lu[p]
+				}
+			}
+		}
+	}
+
+	return &ILU0Preconditioner{n: n, indptr: indptr, ind: ind, lu: lu}
+}
+
+// Apply solves L U z = r via forward then backward substitution, where L (unit lower triangular) and
+// U (upper triangular) are the factors computed by NewILU0Preconditioner, packed together in lu using
+// the original CSR sparsity pattern.
+func (p *ILU0Preconditioner) Apply(z, r *mat64.Vector) {
+	y := make([]float64, p.n)
+	for i := 0; i < p.n; i++ {
+		sum := r.At(i, 0)
+		for k := p.indptr[i]; k < p.indptr[i+1]; k++ {
+			if j := p.ind[k]; j < i {
+				sum -= p.lu[k] * y[j]
+			}
+		}
+		y[i] = sum
+	}
+
+	zz := make([]float64, p.n)
+	for i := p.n - 1; i >= 0; i-- {
+		sum := y[i]
+		diag := 1.0
+		for k := p.indptr[i]; k < p.indptr[i+1]; k++ {
+			switch j := p.ind[k]; {
+			case j == i:
+				diag = p.lu[k]
+			case j > i:
+				sum -= p.lu[k] * zz[j]
+			}
+		}
+		zz[i] = sum / diag
+	}
+
+	for i := 0; i < p.n; i++ {
+		z.SetVec(i, zz[i])
+	}
+}
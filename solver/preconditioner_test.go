@@ -0,0 +1,41 @@
+package solver
+
+import (
+	"math"
+	"testing"
+
+	"github.com/klokare/sparse"
+)
+
+func TestNewILU0PreconditionerSortsUnsortedInput(t *testing.T) {
+	// tridiagonal A:
+	//   4 -1  0
+	//  -1  4 -1
+	//   0 -1  4
+	// row 1 is stored out of column order to exercise the sort-before-factorise fix; without it the
+	// factorisation loop (which assumes ascending column order within a row) silently miscomputes.
+	indptr := []int{0, 2, 5, 7}
+	ind := []int{0, 1, 1, 0, 2, 1, 2}
+	data := []float64{4, -1, 4, -1, -1, -1, 4}
+	a := sparse.NewCSR(3, 3, indptr, ind, data)
+
+	if a.HasSortedIndices() {
+		t.Fatal("test fixture must start unsorted")
+	}
+
+	p := NewILU0Preconditioner(a)
+
+	if !a.HasSortedIndices() {
+		t.Fatal("NewILU0Preconditioner did not sort its input before building the pattern")
+	}
+
+	want := []float64{4, -1, -0.25, 3.75, -1, -1.0 / 3.75, 4 - 1.0/3.75}
+	if len(p.lu) != len(want) {
+		t.Fatalf("lu = %v, want length %d", p.lu, len(want))
+	}
+	for i, w := range want {
+		if math.Abs(p.lu[i]-w) > 1e-9 {
+			t.Errorf("lu[%d] = %v, want %v", i, p.lu[i], w)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package solver
+
+import (
+	"math"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// spmvCapable is implemented by sparse.CSR and sparse.CSC.  Solvers use MulVec in preference to the
+// generic At-based reduction below whenever the coefficient matrix supports it.
+type spmvCapable interface {
+	MulVec(dst, x *mat64.Vector)
+}
+
+func mulVec(a mat64.Matrix, dst, x *mat64.Vector) {
+	if s, ok := a.(spmvCapable); ok {
+		s.MulVec(dst, x)
+		return
+	}
+
+	r, c := a.Dims()
+	for i := 0; i < r; i++ {
+		var v float64
+		for j := 0; j < c; j++ {
+			v += a.At(i, j) * x.At(j, 0)
+		}
+		dst.SetVec(i, v)
+	}
+}
+
+func dot(a, b *mat64.Vector) float64 {
+	n := a.Len()
+	var s float64
+	for i := 0; i < n; i++ {
+		s += a.At(i, 0) * b.At(i, 0)
+	}
+	return s
+}
+
+func norm(a *mat64.Vector) float64 {
+	return math.Sqrt(dot(a, a))
+}
+
+// ConjugateGradient solves A x = b for a symmetric positive-definite A using the (optionally
+// preconditioned) Conjugate Gradient method.  It returns the solution, the number of iterations taken
+// and the final residual norm ||b - A x||.  If precond is nil, no preconditioning is applied.  If a is
+// a sparse.CSR/sparse.CSC built with NewSymCSR/NewSymCSC, its MulVec automatically exploits the
+// symmetric storage, walking only the stored triangle in each iteration.
+func ConjugateGradient(a mat64.Matrix, b *mat64.Vector, precond Preconditioner, maxIter int, tol float64) (x *mat64.Vector, iters int, resNorm float64) {
+	if precond == nil {
+		precond = IdentityPreconditioner{}
+	}
+	n := b.Len()
+	x = mat64.NewVector(n, nil)
+
+	r := mat64.NewVector(n, nil)
+	ax := mat64.NewVector(n, nil)
+	mulVec(a, ax, x)
+	r.SubVec(b, ax)
+	resNorm = norm(r)
+
+	z := mat64.NewVector(n, nil)
+	precond.Apply(z, r)
+
+	p := mat64.NewVector(n, nil)
+	p.CopyVec(z)
+	rz := dot(r, z)
+
+	for iters = 0; iters < maxIter && resNorm > tol; iters++ {
+		ap := mat64.NewVector(n, nil)
+		mulVec(a, ap, p)
+
+		alpha := rz / dot(p, ap)
+		for i := 0; i < n; i++ {
+			x.SetVec(i, x.At(i, 0)+alpha*p.At(i, 0))
+			r.SetVec(i, r.At(i, 0)-alpha*ap.At(i, 0))
+		}
+
+		resNorm = norm(r)
+		if resNorm <= tol {
+			iters++
+			break
+		}
+
+		precond.Apply(z, r)
+		rzNew := dot(r, z)
+		beta := rzNew / rz
+		for i := 0; i < n; i++ {
+			p.SetVec(i, z.At(i, 0)+beta*p.At(i, 0))
+		}
+		rz = rzNew
+	}
+
+	return x, iters, resNorm
+}
+
+// BiCGSTAB solves A x = b for a general (not necessarily symmetric) A using the (optionally
+// preconditioned) stabilised BiConjugate Gradient method.  It returns the solution, the number of
+// iterations taken and the final residual norm ||b - A x||.  If precond is nil, no preconditioning is
+// applied.
+func BiCGSTAB(a mat64.Matrix, b *mat64.Vector, precond Preconditioner, maxIter int, tol float64) (x *mat64.Vector, iters int, resNorm float64) {
+	if precond == nil {
+		precond = IdentityPreconditioner{}
+	}
+	n := b.Len()
+	x = mat64.NewVector(n, nil)
+
+	r := mat64.NewVector(n, nil)
+	ax := mat64.NewVector(n, nil)
+	mulVec(a, ax, x)
+	r.SubVec(b, ax)
+	resNorm = norm(r)
+
+	rhat := mat64.NewVector(n, nil)
+	rhat.CopyVec(r)
+
+	rho, alpha, omega := 1.0, 1.0, 1.0
+	v := mat64.NewVector(n, nil)
+	p := mat64.NewVector(n, nil)
+
+	for iters = 0; iters < maxIter && resNorm > tol; iters++ {
+		rhoNew := dot(rhat, r)
+		if rhoNew == 0 {
+			break
+		}
+
+		if iters == 0 {
+			p.CopyVec(r)
+		} else {
+			beta := (rhoNew / rho) * (alpha / omega)
+			for i := 0; i < n; i++ {
+				p.SetVec(i, r.At(i, 0)+beta*(p.At(i, 0)-omega*v.At(i, 0)))
+			}
+		}
+
+		ph := mat64.NewVector(n, nil)
+		precond.Apply(ph, p)
+		mulVec(a, v, ph)
+
+		alpha = rhoNew / dot(rhat, v)
+
+		s := mat64.NewVector(n, nil)
+		for i := 0; i < n; i++ {
+			s.SetVec(i, r.At(i, 0)-alpha*v.At(i, 0))
+		}
+
+		if sNorm := norm(s); sNorm <= tol {
+			for i := 0; i < n; i++ {
+				x.SetVec(i, x.At(i, 0)+alpha*ph.At(i, 0))
+			}
+			resNorm = sNorm
+			iters++
+			break
+		}
+
+		sh := mat64.NewVector(n, nil)
+		precond.Apply(sh, s)
+		t := mat64.NewVector(n, nil)
+		mulVec(a, t, sh)
+
+		omega = dot(t, s) / dot(t, t)
+		for i := 0; i < n; i++ {
+			x.SetVec(i, x.At(i, 0)+alpha*ph.At(i, 0)+omega*sh.At(i, 0))
+			r.SetVec(i, s.At(i, 0)-omega*t.At(i, 0))
+		}
+
+		resNorm = norm(r)
+		rho = rhoNew
+	}
+
+	return x, iters, resNorm
+}
+
+// GMRES solves A x = b for a general (not necessarily symmetric) A using restarted GMRES(m): the
+// Krylov subspace is rebuilt from the current solution every m inner iterations, bounding the memory
+// and per-iteration cost of the method at the expense of a potentially slower convergence rate than
+// full GMRES.  It returns the solution, the total number of inner iterations taken across all restarts
+// and the final residual norm ||b - A x||.  If precond is nil, no (right) preconditioning is applied.
+func GMRES(a mat64.Matrix, b *mat64.Vector, precond Preconditioner, m, maxRestarts int, tol float64) (x *mat64.Vector, iters int, resNorm float64) {
+	if precond == nil {
+		precond = IdentityPreconditioner{}
+	}
+	n := b.Len()
+	x = mat64.NewVector(n, nil)
+
+	for restart := 0; restart < maxRestarts; restart++ {
+		r := mat64.NewVector(n, nil)
+		ax := mat64.NewVector(n, nil)
+		mulVec(a, ax, x)
+		r.SubVec(b, ax)
+
+		beta := norm(r)
+		resNorm = beta
+		if beta <= tol {
+			return x, iters, resNorm
+		}
+
+		v := make([]*mat64.Vector, m+1)
+		v[0] = mat64.NewVector(n, nil)
+		for i := 0; i < n; i++ {
+			v[0].SetVec(i, r.At(i, 0)/beta)
+		}
+
+		h := make([][]float64, m+1)
+		for i := range h {
+			h[i] = make([]float64, m)
+		}
+		cs := make([]float64, m)
+		sn := make([]float64, m)
+		g := make([]float64, m+1)
+		g[0] = beta
+
+		k := 0
+		for ; k < m; k++ {
+			vh := mat64.NewVector(n, nil)
+			precond.Apply(vh, v[k])
+			w := mat64.NewVector(n, nil)
+			mulVec(a, w, vh)
+
+			// modified Gram-Schmidt Arnoldi step
+			for i := 0; i <= k; i++ {
+				h[i][k] = dot(v[i], w)
+				for j := 0; j < n; j++ {
+					w.SetVec(j, w.At(j, 0)-h[i][k]*v[i].At(j, 0))
+				}
+			}
+			h[k+1][k] = norm(w)
+
+			v[k+1] = mat64.NewVector(n, nil)
+			if h[k+1][k] != 0 {
+				for j := 0; j < n; j++ {
+					v[k+1].SetVec(j, w.At(j, 0)/h[k+1][k])
+				}
+			}
+
+			// apply the previous Givens rotations to the new column of H, then eliminate its subdiagonal
+			for i := 0; i < k; i++ {
+				t := cs[i]*h[i][k] + sn[i]*h[i+1][k]
+				h[i+1][k] = -sn[i]*h[i][k] + cs[i]*h[i+1][k]
+				h[i][k] = t
+			}
+			denom := math.Hypot(h[k][k], h[k+1][k])
+			if denom == 0 {
+				cs[k], sn[k] = 1, 0
+			} else {
+				cs[k] = h[k][k] / denom
+				sn[k] = h[k+1][k] / denom
+			}
+			h[k][k] = cs[k]*h[k][k] + sn[k]*h[k+1][k]
+			h[k+1][k] = 0
+
+			g[k+1] = -sn[k] * g[k]
+			g[k] = cs[k] * g[k]
+
+			resNorm = math.Abs(g[k+1])
+			iters++
+			if resNorm <= tol {
+				k++
+				break
+			}
+		}
+
+		// back-substitute the k x k upper triangular system H y = g
+		y := make([]float64, k)
+		for i := k - 1; i >= 0; i-- {
+			sum := g[i]
+			for j := i + 1; j < k; j++ {
+				sum -= h[i][j] * y[j]
+			}
+			y[i] = sum / h[i][i]
+		}
+
+		update := mat64.NewVector(n, nil)
+		for i := 0; i < k; i++ {
+			for j := 0; j < n; j++ {
+				update.SetVec(j, update.At(j, 0)+y[i]*v[i].At(j, 0))
+			}
+		}
+		mupdate := mat64.NewVector(n, nil)
+		precond.Apply(mupdate, update)
+		for j := 0; j < n; j++ {
+			x.SetVec(j, x.At(j, 0)+mupdate.At(j, 0))
+		}
+
+		if resNorm <= tol {
+			return x, iters, resNorm
+		}
+	}
+
+	return x, iters, resNorm
+}
@@ -0,0 +1,75 @@
+package solver
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gonum/matrix/mat64"
+	"github.com/klokare/sparse"
+)
+
+func checkResidual(t *testing.T, a *sparse.CSR, x, b *mat64.Vector) {
+	t.Helper()
+	n, _ := a.Dims()
+	ax := mat64.NewVector(n, nil)
+	a.MulVec(ax, x)
+	for i := 0; i < n; i++ {
+		if got, want := ax.At(i, 0), b.At(i, 0); math.Abs(got-want) > 1e-6 {
+			t.Errorf("(A*x)[%d] = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestConjugateGradientConvergesOnSPDTridiagonal(t *testing.T) {
+	// SPD tridiagonal 4x4, 2 on the diagonal, -1 off-diagonal.
+	indptr := []int{0, 2, 5, 8, 10}
+	ind := []int{0, 1, 0, 1, 2, 1, 2, 3, 2, 3}
+	data := []float64{2, -1, -1, 2, -1, -1, 2, -1, -1, 2}
+	a := sparse.NewCSR(4, 4, indptr, ind, data)
+	b := mat64.NewVector(4, []float64{1, 0, 0, 1})
+
+	x, iters, resNorm := ConjugateGradient(a, b, nil, 100, 1e-10)
+	if iters == 0 {
+		t.Fatal("ConjugateGradient did not iterate")
+	}
+	if resNorm > 1e-6 {
+		t.Errorf("resNorm = %v, want <= 1e-6", resNorm)
+	}
+	checkResidual(t, a, x, b)
+}
+
+func TestBiCGSTABConvergesOnNonsymmetric(t *testing.T) {
+	// diagonally-dominant, nonsymmetric 3x3.
+	indptr := []int{0, 2, 4, 6}
+	ind := []int{0, 1, 1, 2, 0, 2}
+	data := []float64{4, 1, 3, 1, 2, 5}
+	a := sparse.NewCSR(3, 3, indptr, ind, data)
+	b := mat64.NewVector(3, []float64{1, 2, 3})
+
+	x, iters, resNorm := BiCGSTAB(a, b, nil, 50, 1e-10)
+	if iters == 0 {
+		t.Fatal("BiCGSTAB did not iterate")
+	}
+	if resNorm > 1e-6 {
+		t.Errorf("resNorm = %v, want <= 1e-6", resNorm)
+	}
+	checkResidual(t, a, x, b)
+}
+
+func TestGMRESConvergesOnNonsymmetric(t *testing.T) {
+	// same nonsymmetric 3x3 system as the BiCGSTAB test above.
+	indptr := []int{0, 2, 4, 6}
+	ind := []int{0, 1, 1, 2, 0, 2}
+	data := []float64{4, 1, 3, 1, 2, 5}
+	a := sparse.NewCSR(3, 3, indptr, ind, data)
+	b := mat64.NewVector(3, []float64{1, 2, 3})
+
+	x, iters, resNorm := GMRES(a, b, nil, 3, 10, 1e-10)
+	if iters == 0 {
+		t.Fatal("GMRES did not iterate")
+	}
+	if resNorm > 1e-6 {
+		t.Errorf("resNorm = %v, want <= 1e-6", resNorm)
+	}
+	checkResidual(t, a, x, b)
+}